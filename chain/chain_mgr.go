@@ -25,6 +25,8 @@ import (
 	"path"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 type ChainManager struct {
@@ -38,7 +40,8 @@ type ChainManager struct {
 	childChains          map[string]*Chain
 	childQuits           map[string]<-chan struct{}
 
-	stop chan struct{} // Channel wait for PCHAIN stop
+	stop         chan struct{} // Channel wait for PCHAIN stop
+	stopTimedOut bool          // set by StopChain if a chain was not stopped in time
 
 	server *p2p.PChainP2PServer
 	cch    *CrossChainHelper
@@ -426,7 +429,9 @@ func (cm *ChainManager) formalizeChildChain(chainId string, cci core.CoreChainIn
 	// Child Chain start success, then delete the pending data in chain info db
 	core.DeletePendingChildChainData(cm.cch.chainInfoDB, chainId)
 	// Convert the Chain Info from Pending to Formal
-	core.SaveChainInfo(cm.cch.chainInfoDB, &core.ChainInfo{CoreChainInfo: cci, Epoch: ep})
+	if err := core.SaveChainInfo(cm.cch.chainInfoDB, &core.ChainInfo{CoreChainInfo: cci, Epoch: ep}); err != nil {
+		log.Errorf("Failed to save chain info of child chain %v: %v", chainId, err)
+	}
 }
 
 func (cm *ChainManager) checkCoinbaseInChildChain(childEpoch *epoch.Epoch) bool {
@@ -441,37 +446,96 @@ func (cm *ChainManager) checkCoinbaseInChildChain(childEpoch *epoch.Epoch) bool
 	return childEpoch.Validators.HasAddress(localEtherbase[:])
 }
 
+// chainStopTimeout bounds how long we wait for a single chain to stop
+const chainStopTimeout = 60 * time.Second
+
+// StopChain stops the child chains first, as they depend on the main chain
+// through the cross chain helper, then the main chain. The shared databases
+// are only closed afterwards in Stop.
 func (cm *ChainManager) StopChain() {
-	go func() {
-		mainChainError := cm.mainChain.EthNode.Close()
-		if mainChainError != nil {
-			log.Error("Error when closing main chain", "err", mainChainError)
-		} else {
-			log.Info("Main Chain Closed")
+	closeNode := func(chain *Chain) error { return chain.EthNode.Close() }
+	cm.stopTimedOut = !stopChains(cm.childChains, cm.mainChain, closeNode, chainStopTimeout)
+}
+
+// stopChains closes the child chains concurrently, then the main chain. It returns
+// false if a chain was not stopped after the timeout, its node may still be closing.
+func stopChains(children map[string]*Chain, main *Chain, closeNode func(*Chain) error, timeout time.Duration) bool {
+	var (
+		wg       sync.WaitGroup
+		timedOut int32
+	)
+	for _, child := range children {
+		wg.Add(1)
+		go func(child *Chain) {
+			defer wg.Done()
+			if err := closeChain(child, closeNode, timeout); err != nil {
+				if err == errChainStopTimeout {
+					atomic.StoreInt32(&timedOut, 1)
+				}
+				log.Error("Error when closing child chain", "child id", child.Id, "err", err)
+			} else {
+				log.Info("Child Chain Closed", "child id", child.Id)
+			}
+		}(child)
+	}
+	wg.Wait()
+
+	if err := closeChain(main, closeNode, timeout); err != nil {
+		if err == errChainStopTimeout {
+			atomic.StoreInt32(&timedOut, 1)
 		}
+		log.Error("Error when closing main chain", "err", err)
+	} else {
+		log.Info("Main Chain Closed")
+	}
+	return atomic.LoadInt32(&timedOut) == 0
+}
+
+var errChainStopTimeout = errors.New("chain not stopped before the timeout")
+
+// closeChain closes the node of the chain, giving up after the timeout so a
+// stuck chain doesn't hang the shutdown.
+func closeChain(chain *Chain, closeNode func(*Chain) error, timeout time.Duration) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- closeNode(chain)
 	}()
-	for _, child := range cm.childChains {
-		go func() {
-			childChainError := child.EthNode.Close()
-			if childChainError != nil {
-				log.Error("Error when closing child chain", "child id", child.Id, "err", childChainError)
-			}
-		}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return errChainStopTimeout
 	}
 }
 
 func (cm *ChainManager) WaitChainsStop() {
-	<-cm.mainQuit
+	timeout := time.After(chainStopTimeout)
 	for _, quit := range cm.childQuits {
-		<-quit
+		select {
+		case <-quit:
+		case <-timeout:
+			log.Error("Timeout waiting for child chains to stop")
+			return
+		}
+	}
+	select {
+	case <-cm.mainQuit:
+	case <-timeout:
+		log.Error("Timeout waiting for main chain to stop")
 	}
 }
 
 func (cm *ChainManager) Stop() {
 	rpc.StopRPC()
 	cm.server.Stop()
-	cm.cch.localTX3CacheDB.Close()
-	cm.cch.chainInfoDB.Close()
+	// A chain still closing may write to the shared databases, leave them to the process exit
+	if cm.stopTimedOut {
+		log.Error("Chains not stopped in time, the shared databases are left open")
+	} else {
+		cm.cch.localTX3CacheDB.Close()
+		cm.cch.chainInfoDB.Close()
+	}
 
 	// Release the main routine
 	close(cm.stop)
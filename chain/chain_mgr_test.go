@@ -0,0 +1,71 @@
+package chain
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// Tests that the main chain is only closed once all the child chains are.
+func TestStopChainsOrder(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		closed []string
+	)
+	closeNode := func(chain *Chain) error {
+		mu.Lock()
+		defer mu.Unlock()
+		closed = append(closed, chain.Id)
+		return nil
+	}
+	children := map[string]*Chain{
+		"child_0": {Id: "child_0"},
+		"child_1": {Id: "child_1"},
+		"child_2": {Id: "child_2"},
+	}
+	if !stopChains(children, &Chain{Id: "pchain"}, closeNode, time.Second) {
+		t.Fatal("expected all the chains to stop in time")
+	}
+	if len(closed) != len(children)+1 {
+		t.Fatalf("closed chains mismatch: have %v, want %d", closed, len(children)+1)
+	}
+	if last := closed[len(closed)-1]; last != "pchain" {
+		t.Fatalf("last closed chain mismatch: have %s, want pchain", last)
+	}
+}
+
+// Tests that a child chain stuck closing is reported, and doesn't keep the
+// main chain from being closed.
+func TestStopChainsTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	mainClosed := make(chan struct{})
+	closeNode := func(chain *Chain) error {
+		switch chain.Id {
+		case "child_0":
+			<-release
+		case "pchain":
+			close(mainClosed)
+		}
+		return nil
+	}
+	children := map[string]*Chain{"child_0": {Id: "child_0"}}
+	if stopChains(children, &Chain{Id: "pchain"}, closeNode, 50*time.Millisecond) {
+		t.Fatal("expected the stuck child chain to be reported")
+	}
+	select {
+	case <-mainClosed:
+	default:
+		t.Fatal("expected the main chain to be closed")
+	}
+
+	// The main chain stuck alone is reported as well
+	closeNode = func(chain *Chain) error {
+		<-release
+		return nil
+	}
+	if stopChains(nil, &Chain{Id: "pchain"}, closeNode, 50*time.Millisecond) {
+		t.Fatal("expected the stuck main chain to be reported")
+	}
+}
@@ -82,8 +82,11 @@ func (cch *CrossChainHelper) CanCreateChildChain(from common.Address, chainId st
 		return errors.New("you can't create PChain as a child chain, try use other name instead")
 	}
 
-	// Check if "chainId" has been created
-	ci := core.GetChainInfo(cch.chainInfoDB, chainId)
+	// Check if "chainId" has been created, a corrupted chain info must not be taken for a free id
+	ci, err := core.LoadChainInfo(cch.chainInfoDB, chainId)
+	if err != nil {
+		return fmt.Errorf("chain %s: %v", chainId, err)
+	}
 	if ci != nil {
 		return fmt.Errorf("Chain %s has already exist, try use other name instead", chainId)
 	}
@@ -160,7 +163,9 @@ func (cch *CrossChainHelper) ValidateJoinChildChain(from common.Address, consens
 	// Check if "chainId" has been created/registered
 	ci := core.GetPendingChildChainData(cch.chainInfoDB, chainId)
 	if ci == nil {
-		if core.GetChainInfo(cch.chainInfoDB, chainId) != nil {
+		if created, err := core.LoadChainInfo(cch.chainInfoDB, chainId); err != nil {
+			return fmt.Errorf("chain %s: %v", chainId, err)
+		} else if created != nil {
 			return fmt.Errorf("chain %s has already created/started, try use other name instead", chainId)
 		} else {
 			return fmt.Errorf("child chain %s not exist, try use other name instead", chainId)
@@ -234,8 +239,8 @@ func (cch *CrossChainHelper) ReadyForLaunchChildChain(height *big.Int, stateDB *
 	return readyId, updateBytes, removedId
 }
 
-func (cch *CrossChainHelper) ProcessPostPendingData(newPendingIdxBytes []byte, deleteChildChainIds []string) {
-	core.ProcessPostPendingData(cch.chainInfoDB, newPendingIdxBytes, deleteChildChainIds)
+func (cch *CrossChainHelper) ProcessPostPendingData(newPendingIdxBytes []byte, deleteChildChainIds []string, launchChildChainIds []string, fireable tdmTypes.Fireable) {
+	core.ProcessPostPendingData(cch.chainInfoDB, newPendingIdxBytes, deleteChildChainIds, launchChildChainIds, fireable)
 }
 
 func (cch *CrossChainHelper) VoteNextEpoch(ep *epoch.Epoch, from common.Address, voteHash common.Hash, txHash common.Hash) error {
@@ -453,8 +458,11 @@ func (cch *CrossChainHelper) SaveChildChainProofDataToMainChain(bs []byte) error
 				// New Epoch, save or update the Epoch into Chain Info DB
 				ci.EpochNumber = ep.Number
 				ci.Epoch = ep
-				core.SaveChainInfo(cch.chainInfoDB, ci)
-				log.Infof("Epoch saved from chain: %s, epoch: %v", chainId, ep)
+				if err := core.SaveChainInfo(cch.chainInfoDB, ci); err != nil {
+					log.Errorf("Failed to save epoch from chain: %s, err: %v", chainId, err)
+				} else {
+					log.Infof("Epoch saved from chain: %s, epoch: %v", chainId, ep)
+				}
 			}
 		}
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/consensus/tendermint/epoch"
 	"github.com/ethereum/go-ethereum/consensus/tendermint/types"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
@@ -39,8 +40,9 @@ const (
 )
 
 type BalaceAmount struct {
-	balance string
-	amount  string
+	balance   string
+	amount    string
+	lockUntil string // optional, empty if the amount is not locked
 }
 
 type InvalidArgs struct {
@@ -52,7 +54,7 @@ func (invalid InvalidArgs) Error() string {
 }
 
 func parseBalaceAmount(s string) ([]*BalaceAmount, error) {
-	r, _ := regexp.Compile("\\{[\\ \\t]*\\d+(\\.\\d+)?[\\ \\t]*\\,[\\ \\t]*\\d+(\\.\\d+)?[\\ \\t]*\\}")
+	r, _ := regexp.Compile("\\{[\\ \\t]*\\d+(\\.\\d+)?[\\ \\t]*\\,[\\ \\t]*\\d+(\\.\\d+)?[\\ \\t]*(\\,[\\ \\t]*\\d+[\\ \\t]*)?\\}")
 	parse_strs := r.FindAllString(s, -1)
 	if len(parse_strs) == 0 {
 		return nil, InvalidArgs{s}
@@ -61,14 +63,40 @@ func parseBalaceAmount(s string) ([]*BalaceAmount, error) {
 	for i, v := range parse_strs {
 		length := len(v)
 		balanceAmount := strings.Split(v[1:length-1], ",")
-		if len(balanceAmount) != 2 {
+		if len(balanceAmount) != 2 && len(balanceAmount) != 3 {
 			return nil, InvalidArgs{s}
 		}
-		balanceAmounts[i] = &BalaceAmount{strings.TrimSpace(balanceAmount[0]), strings.TrimSpace(balanceAmount[1])}
+		balanceAmounts[i] = &BalaceAmount{balance: strings.TrimSpace(balanceAmount[0]), amount: strings.TrimSpace(balanceAmount[1])}
+		if len(balanceAmount) == 3 {
+			balanceAmounts[i].lockUntil = strings.TrimSpace(balanceAmount[2])
+		}
 	}
 	return balanceAmounts, nil
 }
 
+// validateBalanceAmounts checks that every validator can afford its deposit
+func validateBalanceAmounts(balanceAmounts []*BalaceAmount) error {
+	for i, ba := range balanceAmounts {
+		balance, ok := math.ParseBig256(ba.balance)
+		if !ok {
+			return fmt.Errorf("validator %d: invalid balance %v", i, ba.balance)
+		}
+		amount, ok := math.ParseBig256(ba.amount)
+		if !ok {
+			return fmt.Errorf("validator %d: invalid amount %v", i, ba.amount)
+		}
+		if balance.Cmp(amount) < 0 {
+			return fmt.Errorf("validator %d: balance %v is less than amount %v", i, balance, amount)
+		}
+		if ba.lockUntil != "" {
+			if _, ok := math.ParseUint64(ba.lockUntil); !ok {
+				return fmt.Errorf("validator %d: invalid lock until %v", i, ba.lockUntil)
+			}
+		}
+	}
+	return nil
+}
+
 func InitCmd(ctx *cli.Context) error {
 
 	// ethereum genesis.json
@@ -164,6 +192,10 @@ func init_eth_genesis(config cfg.Config, balStr string) error {
 		utils.Fatalf("init eth_genesis_file failed")
 		return err
 	}
+	if err := validateBalanceAmounts(balanceAmounts); err != nil {
+		utils.Fatalf("init eth_genesis_file failed: %v", err)
+		return err
+	}
 
 	validators := createPriValidators(config, len(balanceAmounts))
 
@@ -180,10 +212,15 @@ func init_eth_genesis(config cfg.Config, balStr string) error {
 		Alloc:      core.GenesisAlloc{},
 	}
 	for i, validator := range validators {
-		coreGenesis.Alloc[validator.Address] = core.GenesisAccount{
+		account := core.GenesisAccount{
 			Balance: math.MustParseBig256(balanceAmounts[i].balance),
 			Amount:  math.MustParseBig256(balanceAmounts[i].amount),
 		}
+		if balanceAmounts[i].lockUntil != "" {
+			// Checked by validateBalanceAmounts
+			account.LockUntil, _ = math.ParseUint64(balanceAmounts[i].lockUntil)
+		}
+		coreGenesis.Alloc[validator.Address] = account
 	}
 
 	contents, err := json.MarshalIndent(coreGenesis, "", "\t")
@@ -287,6 +324,9 @@ func createGenesisDoc(config cfg.Config, chainId string, coreGenesis *core.Genes
 				TotalYear:          0,
 			}
 		}
+		if err := epoch.ValidateRewardScheme(&rewardScheme); err != nil {
+			return err
+		}
 
 		var rewardPerBlock *big.Int
 		if chainId == MainChain || chainId == TestnetChain {
@@ -310,9 +350,9 @@ func createGenesisDoc(config cfg.Config, chainId string, coreGenesis *core.Genes
 		}
 
 		if privValidator != nil {
-			coinbase, amount, checkErr := checkAccount(*coreGenesis)
+			coinbase, amount, lockUntil, checkErr := checkAccount(*coreGenesis)
 			if checkErr != nil {
-				log.Infof(checkErr.Error())
+				log.Info(checkErr.Error())
 				cmn.Exit(checkErr.Error())
 			}
 
@@ -320,6 +360,7 @@ func createGenesisDoc(config cfg.Config, chainId string, coreGenesis *core.Genes
 				EthAccount: coinbase,
 				PubKey:     privValidator.PubKey,
 				Amount:     amount,
+				LockUntil:  lockUntil,
 			}}
 		} else if validators != nil {
 			genDoc.CurrentEpoch.Validators = validators
@@ -385,7 +426,7 @@ func createPriValidators(config cfg.Config, num int) []*types.PrivValidator {
 	return validators
 }
 
-func checkAccount(coreGenesis core.Genesis) (common.Address, *big.Int, error) {
+func checkAccount(coreGenesis core.Genesis) (common.Address, *big.Int, uint64, error) {
 
 	coinbase := coreGenesis.Coinbase
 	log.Infof("checkAccount(), coinbase is %x", coinbase)
@@ -393,11 +434,13 @@ func checkAccount(coreGenesis core.Genesis) (common.Address, *big.Int, error) {
 	var act common.Address
 	amount := big.NewInt(-1)
 	balance := big.NewInt(-1)
+	var lockUntil uint64
 	found := false
 	for address, account := range coreGenesis.Alloc {
-		log.Infof("checkAccount(), address is %x, balance is %v, amount is %v", address, account.Balance, account.Amount)
+		log.Infof("checkAccount(), address is %x, balance is %v, amount is %v, lock until %v", address, account.Balance, account.Amount, account.LockUntil)
 		balance = account.Balance
 		amount = account.Amount
+		lockUntil = account.LockUntil
 		act = address
 		found = true
 		break
@@ -405,15 +448,15 @@ func checkAccount(coreGenesis core.Genesis) (common.Address, *big.Int, error) {
 
 	if !found {
 		log.Error("invalidate eth_account")
-		return common.Address{}, nil, errors.New("invalidate eth_account")
+		return common.Address{}, nil, 0, errors.New("invalidate eth_account")
 	}
 
 	if balance.Sign() == -1 || amount.Sign() == -1 {
 		log.Errorf("balance / amount can't be negative integer, balance is %v, amount is %v", balance, amount)
-		return common.Address{}, nil, errors.New("no enough balance")
+		return common.Address{}, nil, 0, errors.New("no enough balance")
 	}
 
-	return act, amount, nil
+	return act, amount, lockUntil, nil
 }
 
 func initEthGenesisFromExistValidator(childChainID string, childConfig cfg.Config, validators []types.GenesisValidator) error {
@@ -0,0 +1,93 @@
+package chain
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+func TestParseBalaceAmount(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []BalaceAmount
+	}{
+		{"{10, 5}", []BalaceAmount{{balance: "10", amount: "5"}}},
+		{"{10,5,100}", []BalaceAmount{{balance: "10", amount: "5", lockUntil: "100"}}},
+		{"{10, 5}, { 20 , 6 , 200 }", []BalaceAmount{
+			{balance: "10", amount: "5"},
+			{balance: "20", amount: "6", lockUntil: "200"},
+		}},
+	}
+	for _, test := range tests {
+		got, err := parseBalaceAmount(test.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", test.input, err)
+		}
+		if len(got) != len(test.want) {
+			t.Fatalf("%q: length mismatch: have %d, want %d", test.input, len(got), len(test.want))
+		}
+		for i := range got {
+			if *got[i] != test.want[i] {
+				t.Errorf("%q: entry %d mismatch: have %+v, want %+v", test.input, i, *got[i], test.want[i])
+			}
+		}
+	}
+
+	for _, input := range []string{"", "{10}", "{10, 5, 1.5}"} {
+		if _, err := parseBalaceAmount(input); err == nil {
+			t.Errorf("%q: expected an error", input)
+		}
+	}
+}
+
+func TestCheckAccountLockUntil(t *testing.T) {
+	addr := common.HexToAddress("0x1")
+	genesis := core.Genesis{Alloc: core.GenesisAlloc{
+		addr: {Balance: big.NewInt(10), Amount: big.NewInt(5), LockUntil: 100},
+	}}
+	act, amount, lockUntil, err := checkAccount(genesis)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if act != addr || amount.Cmp(big.NewInt(5)) != 0 || lockUntil != 100 {
+		t.Fatalf("account mismatch: have (%x, %v, %d), want (%x, 5, 100)", act, amount, lockUntil, addr)
+	}
+}
+
+func TestValidateBalanceAmounts(t *testing.T) {
+	valid := []*BalaceAmount{
+		{balance: "10", amount: "5"},
+		{balance: "20", amount: "20"},
+	}
+	if err := validateBalanceAmounts(valid); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	underFunded := []*BalaceAmount{
+		{balance: "10", amount: "5"},
+		{balance: "20", amount: "21"},
+		{balance: "30", amount: "10"},
+	}
+	err := validateBalanceAmounts(underFunded)
+	if err == nil {
+		t.Fatal("expected an under-funded validator to fail")
+	}
+	if !strings.HasPrefix(err.Error(), "validator 1:") {
+		t.Fatalf("error should name the validator index: %v", err)
+	}
+
+	// A lock until out of the uint64 range is rejected instead of panicking
+	err = validateBalanceAmounts([]*BalaceAmount{
+		{balance: "10", amount: "5", lockUntil: "100"},
+		{balance: "20", amount: "5", lockUntil: "18446744073709551616"},
+	})
+	if err == nil {
+		t.Fatal("expected an out of range lock until to fail")
+	}
+	if !strings.HasPrefix(err.Error(), "validator 1:") {
+		t.Fatalf("error should name the validator index: %v", err)
+	}
+}